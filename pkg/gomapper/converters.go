@@ -0,0 +1,104 @@
+package gomapper
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// converterFunc converts src into dest in place, e.g. by calling dest.Set.
+// It returns errConverterNotApplicable when src isn't a type the converter
+// knows how to handle, so mapValues can fall back to its default dispatch.
+type converterFunc func(src, dest reflect.Value) error
+
+// errConverterNotApplicable signals that a registered converter doesn't
+// handle the source value it was offered. It never escapes this package.
+var errConverterNotApplicable = errors.New("gomapper: converter not applicable for source type")
+
+// WithConverter registers fn, which converts an S to a D, on option and
+// returns option so calls can be chained. Lookups happen by destination
+// type (D) first; if a converter is already registered for D, fn is tried
+// only when that earlier converter reports its source type doesn't match.
+func WithConverter[S, D any](option *Option, fn func(S) (D, error)) *Option {
+	if option.Converters == nil {
+		option.Converters = map[reflect.Type]converterFunc{}
+	}
+
+	destType := reflect.TypeOf((*D)(nil)).Elem()
+
+	convert := converterFunc(func(src, dest reflect.Value) error {
+		typedSrc, ok := src.Interface().(S)
+		if !ok {
+			return errConverterNotApplicable
+		}
+
+		result, err := fn(typedSrc)
+		if err != nil {
+			return errors.New(fmt.Sprintf("gomapper: error converting %T to %T: %v", typedSrc, result, err))
+		}
+
+		dest.Set(reflect.ValueOf(result))
+		return nil
+	})
+
+	if existing, ok := option.Converters[destType]; ok {
+		option.Converters[destType] = chainConverters(existing, convert)
+	} else {
+		option.Converters[destType] = convert
+	}
+
+	return option
+}
+
+// chainConverters tries first, falling back to second when first reports its
+// source type doesn't apply. This lets multiple source types converge on the
+// same destination type under a single Converters entry.
+func chainConverters(first, second converterFunc) converterFunc {
+	return func(src, dest reflect.Value) error {
+		if err := first(src, dest); err != errConverterNotApplicable {
+			return err
+		}
+		return second(src, dest)
+	}
+}
+
+// defaultConverters backs Option.DefaultConverters: a small preset of
+// conversions between common stdlib types that the generic reflection path
+// in mapValues can't bridge on its own.
+var defaultConverters = map[reflect.Type]converterFunc{
+	reflect.TypeOf(time.Time{}): func(src, dest reflect.Value) error {
+		s, ok := src.Interface().(string)
+		if !ok {
+			return errConverterNotApplicable
+		}
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return errors.New(fmt.Sprintf("gomapper: error converting string to time.Time: %v", err))
+		}
+		dest.Set(reflect.ValueOf(t))
+		return nil
+	},
+	reflect.TypeOf(""): func(src, dest reflect.Value) error {
+		t, ok := src.Interface().(time.Time)
+		if !ok {
+			return errConverterNotApplicable
+		}
+		dest.SetString(t.Format(time.RFC3339))
+		return nil
+	},
+	reflect.TypeOf(sql.NullString{}): func(src, dest reflect.Value) error {
+		s, ok := src.Interface().(*string)
+		if !ok {
+			return errConverterNotApplicable
+		}
+		if s == nil {
+			dest.Set(reflect.ValueOf(sql.NullString{}))
+			return nil
+		}
+		dest.Set(reflect.ValueOf(sql.NullString{String: *s, Valid: true}))
+		return nil
+	},
+}
@@ -0,0 +1,104 @@
+package gomapper
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// FieldError pairs the path of the field being mapped, e.g.
+// "Flavor.Roles[2].Size" or `MapX["key1"].Name`, with the error that
+// occurred while mapping it.
+type FieldError struct {
+	Path string
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Err.Error())
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// MapError collects every FieldError found while mapping a source into a
+// dest, rather than aborting at the first one. See Option.StopOnFirstError to
+// restore the previous fail-fast behavior.
+type MapError struct {
+	Errors []*FieldError
+}
+
+func (e *MapError) Error() string {
+	parts := make([]string, len(e.Errors))
+	for i, fe := range e.Errors {
+		parts[i] = fe.Error()
+	}
+	return fmt.Sprintf("gomapper: %d error(s) while mapping:\n%s", len(e.Errors), strings.Join(parts, "\n"))
+}
+
+func (e *MapError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, fe := range e.Errors {
+		errs[i] = fe
+	}
+	return errs
+}
+
+// errorCollector accumulates FieldErrors for a single Map call.
+type errorCollector struct {
+	errs []*FieldError
+}
+
+func (c *errorCollector) add(path string, err error) {
+	c.errs = append(c.errs, &FieldError{Path: path, Err: err})
+}
+
+func (c *errorCollector) asError() error {
+	if len(c.errs) == 0 {
+		return nil
+	}
+	return &MapError{Errors: c.errs}
+}
+
+// mapContext carries the current Option and the error collector shared by an
+// entire Map call. It's cloned (via withOption) rather than mutated whenever
+// a field narrows the option it hands to its children, e.g. via Filter.
+type mapContext struct {
+	option    *Option
+	collector *errorCollector
+}
+
+func newMapContext(option *Option) *mapContext {
+	return &mapContext{option: option, collector: &errorCollector{}}
+}
+
+func (ctx *mapContext) withOption(option *Option) *mapContext {
+	return &mapContext{option: option, collector: ctx.collector}
+}
+
+// fail records err at path and returns nil so mapping of the rest of the
+// tree continues, unless Option.StopOnFirstError is set, in which case it
+// returns err so the caller aborts immediately.
+func (ctx *mapContext) fail(path string, err error) error {
+	if ctx.option.StopOnFirstError {
+		return err
+	}
+	ctx.collector.add(path, err)
+	return nil
+}
+
+func joinPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+func indexPath(parent string, i int) string {
+	return fmt.Sprintf("%s[%d]", parent, i)
+}
+
+func mapKeyPath(parent string, key reflect.Value) string {
+	return fmt.Sprintf("%s[%q]", parent, fmt.Sprint(key.Interface()))
+}
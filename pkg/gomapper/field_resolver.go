@@ -0,0 +1,64 @@
+package gomapper
+
+import "reflect"
+
+// structTagKey is the struct tag consulted when resolving field names, e.g.
+// `gomapper:"src_field_name"`. A tag value of "-" excludes the field from
+// mapping entirely, on either the source or the destination side.
+const structTagKey = "gomapper"
+
+// fieldKey returns the key a struct field is matched by: its `gomapper` tag
+// value if one is present, otherwise the result of applying nameMapper to its
+// Go name. skip is true when the field is tagged `gomapper:"-"` and must be
+// left out of mapping altogether.
+func fieldKey(field reflect.StructField, nameMapper func(string) string) (key string, skip bool) {
+	if tag, ok := field.Tag.Lookup(structTagKey); ok {
+		if tag == "-" {
+			return "", true
+		}
+		return tag, false
+	}
+	return nameMapper(field.Name), false
+}
+
+// findSourceField locates the source struct field whose key (see fieldKey)
+// matches key, walking promoted fields of embedded/anonymous structs the same
+// way reflect.Value.FieldByName would. matched reports whether a field with
+// that key was found at all; reachable reports whether its value could
+// actually be read, which is false when it is promoted through a nil embedded
+// pointer.
+func findSourceField(source reflect.Value, key string, nameMapper func(string) string) (value reflect.Value, matched bool, reachable bool) {
+	for _, sf := range reflect.VisibleFields(source.Type()) {
+		if !sf.IsExported() {
+			continue
+		}
+
+		sfKey, skip := fieldKey(sf, nameMapper)
+		if skip || sfKey != key {
+			continue
+		}
+
+		v, ok := fieldByIndex(source, sf.Index)
+		return v, true, ok
+	}
+
+	return reflect.Value{}, false, false
+}
+
+// fieldByIndex is the nil-safe counterpart of reflect.Value.FieldByIndex: it
+// reports ok == false instead of panicking when the index path descends
+// through a nil embedded pointer.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, fieldIndex := range index {
+		if i > 0 {
+			if v.Kind() == reflect.Ptr {
+				if v.IsNil() {
+					return reflect.Value{}, false
+				}
+				v = v.Elem()
+			}
+		}
+		v = v.Field(fieldIndex)
+	}
+	return v, true
+}
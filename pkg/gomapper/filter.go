@@ -0,0 +1,93 @@
+package gomapper
+
+import "strings"
+
+// FieldFilter restricts which destination fields a Map call copies into.
+// Before descending into a field, mapField consults Filter(fieldName); if ok
+// is false the field is left at its destination zero value. If the field is
+// itself a struct, slice or map, subFilter governs its children in turn, and
+// for slices/maps the same subFilter applies to every element.
+type FieldFilter interface {
+	Filter(fieldName string) (subFilter FieldFilter, ok bool)
+}
+
+// maskNode is one level of a dotted field path such as "Flavor.Roles.Size".
+type maskNode struct {
+	children map[string]*maskNode
+}
+
+func newMaskNode() *maskNode {
+	return &maskNode{children: map[string]*maskNode{}}
+}
+
+func (n *maskNode) add(path []string) {
+	if len(path) == 0 {
+		return
+	}
+
+	child, ok := n.children[path[0]]
+	if !ok {
+		child = newMaskNode()
+		n.children[path[0]] = child
+	}
+	child.add(path[1:])
+}
+
+func buildMaskTree(paths []string) *maskNode {
+	root := newMaskNode()
+	for _, path := range paths {
+		root.add(strings.Split(path, "."))
+	}
+	return root
+}
+
+// maskFromPaths is a FieldFilter that only maps fields reachable through the
+// dotted paths it was built from.
+type maskFromPaths struct {
+	node *maskNode
+}
+
+// MaskFromPaths builds a FieldFilter that only maps the given dotted field
+// paths, e.g. MaskFromPaths([]string{"Flavor.Roles.Size"}). Every other field
+// is left at its destination zero value.
+func MaskFromPaths(paths []string) FieldFilter {
+	return &maskFromPaths{node: buildMaskTree(paths)}
+}
+
+func (m *maskFromPaths) Filter(fieldName string) (FieldFilter, bool) {
+	child, ok := m.node.children[fieldName]
+	if !ok {
+		return nil, false
+	}
+	if len(child.children) == 0 {
+		// This field is the leaf of a requested path: map it (and anything
+		// beneath it) without any further restriction.
+		return nil, true
+	}
+	return &maskFromPaths{node: child}, true
+}
+
+// maskInverse is a FieldFilter that maps every field except the ones
+// reachable through its dotted paths.
+type maskInverse struct {
+	node *maskNode
+}
+
+// MaskInverse builds a FieldFilter that maps every field except the given
+// dotted field paths, e.g. MaskInverse([]string{"Flavor.Roles.Size"}).
+func MaskInverse(paths []string) FieldFilter {
+	return &maskInverse{node: buildMaskTree(paths)}
+}
+
+func (m *maskInverse) Filter(fieldName string) (FieldFilter, bool) {
+	child, ok := m.node.children[fieldName]
+	if !ok {
+		return nil, true
+	}
+	if len(child.children) == 0 {
+		// This field is the leaf of an excluded path: mask it (and anything
+		// beneath it) out entirely.
+		return nil, false
+	}
+	return &maskInverse{node: child}, true
+}
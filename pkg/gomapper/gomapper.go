@@ -24,18 +24,87 @@ type Option struct {
 	// type types of nested structs/slices follow the same rules, i.e. all fields
 	// in destination structs must be found on the source struct.
 	Exact bool
+
+	// NameMapper is applied to a field's name to derive the key used to match it
+	// against the other side, unless that field carries an explicit `gomapper`
+	// struct tag. This lets callers bridge differing naming conventions, e.g.
+	// JSON-style snake_case DTOs and Go CamelCase structs. Defaults to identity.
+	NameMapper func(string) string
+
+	// Filter restricts which destination fields are mapped, e.g. for PATCH-style
+	// partial updates. Fields it excludes are left at their destination zero
+	// value and, if Exact is true, do not trigger a "not found in source" error.
+	// See MaskFromPaths and MaskInverse. Defaults to nil, which maps everything.
+	Filter FieldFilter
+
+	// Converters are consulted, keyed by destination type, before the default
+	// struct/slice/map/ptr dispatch. They let callers handle cross-type
+	// conversions the generic reflection path can't, e.g. time.Time <-> string.
+	// Build entries with WithConverter rather than populating this map by hand.
+	Converters map[reflect.Type]converterFunc
+
+	// DefaultConverters enables a built-in preset of common stdlib conversions
+	// (currently time.Time <-> string and *string -> sql.NullString) in
+	// addition to anything registered in Converters. There is no preset for
+	// sql.NullString -> *string.
+	DefaultConverters bool
+
+	// StopOnFirstError restores the pre-multi-error behavior of aborting the
+	// whole Map call at the first incompatibility found, instead of recording
+	// it and continuing to map the rest of the tree. Defaults to false.
+	StopOnFirstError bool
+}
+
+// converter returns the converter that applies to destType, preferring a
+// user-registered one over the DefaultConverters preset.
+func (o *Option) converter(destType reflect.Type) (converterFunc, bool) {
+	if o.Converters != nil {
+		if fn, ok := o.Converters[destType]; ok {
+			return fn, true
+		}
+	}
+	if o.DefaultConverters {
+		if fn, ok := defaultConverters[destType]; ok {
+			return fn, true
+		}
+	}
+	return nil, false
+}
+
+func (o *Option) withFilter(filter FieldFilter) *Option {
+	narrowed := *o
+	narrowed.Filter = filter
+	return &narrowed
 }
 
 func getDefaultOption() *Option {
+	// NameMapper is left nil (rather than set to identityNameMapper) so that
+	// nameMapper()'s nil check, and getOrBuildPlan's cache-eligibility check,
+	// can tell "caller didn't customize this" from "caller did".
 	return &Option{
 		Exact: false,
 	}
 }
 
+func identityNameMapper(name string) string {
+	return name
+}
+
+func (o *Option) nameMapper() func(string) string {
+	if o.NameMapper != nil {
+		return o.NameMapper
+	}
+	return identityNameMapper
+}
+
 // Map uses parametric options to fill out the fields in dest with values from source.
 // If options does not provided it uses default map options.
 // Embedded/anonymous structs are supported.
 // Values that are not exported/not public will not be mapped.
+// By default every incompatibility found while walking source and dest is
+// recorded and mapping continues; the returned error, if any, is a *MapError
+// covering all of them. Set Option.StopOnFirstError to abort at the first one
+// instead.
 func Map(source, dest any, options ...*Option) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -66,7 +135,12 @@ func Map(source, dest any, options ...*Option) (err error) {
 		sourceVal = reflect.ValueOf(source).Elem()
 	}
 
-	return mapValues(sourceVal, reflect.ValueOf(dest).Elem(), option.Exact)
+	ctx := newMapContext(option)
+	if err := mapValues(sourceVal, reflect.ValueOf(dest).Elem(), ctx, ""); err != nil {
+		return err
+	}
+
+	return ctx.collector.asError()
 }
 
 // Same as Map function but panics in case of any error instead of returning error.
@@ -88,12 +162,25 @@ func verifyMapOption(options ...*Option) (*Option, error) {
 	return options[0], nil
 }
 
-func mapValues(sourceVal, destVal reflect.Value, exact bool) error {
+func mapValues(sourceVal, destVal reflect.Value, ctx *mapContext, path string) error {
+	if conv, ok := ctx.option.converter(destVal.Type()); ok {
+		err := conv(sourceVal, destVal)
+		if err != errConverterNotApplicable {
+			if err != nil {
+				return ctx.fail(path, err)
+			}
+			return nil
+		}
+	}
+
 	// If the types are equal, map to destination from the top.
 	// This can cause side effects, because pointer fields will point
 	// to the same structure. In practice we are using this tool for transferring
 	// data between layers. Not using for deep copy purposes. This is acceptable.
-	if destVal.CanSet() && destVal.Type() == sourceVal.Type() {
+	// Skipped when a Filter is set: the whole point of Filter is to map a subset
+	// of fields between two values of the same type, which this shortcut would
+	// otherwise bypass entirely.
+	if destVal.CanSet() && destVal.Type() == sourceVal.Type() && ctx.option.Filter == nil {
 		destVal.Set(sourceVal)
 		return nil
 	}
@@ -103,7 +190,7 @@ func mapValues(sourceVal, destVal reflect.Value, exact bool) error {
 			return nil
 		}
 		destValZeroPtr := reflect.New(destVal.Type().Elem())
-		if err := mapValues(sourceVal, destValZeroPtr.Elem(), exact); err != nil {
+		if err := mapValues(sourceVal, destValZeroPtr.Elem(), ctx, path); err != nil {
 			return err
 		}
 		destVal.Set(destValZeroPtr)
@@ -119,10 +206,18 @@ func mapValues(sourceVal, destVal reflect.Value, exact bool) error {
 			sourceVal = sourceVal.Elem()
 		}
 		if sourceVal.Kind() != reflect.Struct {
-			return errors.New("gomapper: error mapping values: dest kind: struct, source kind: " + sourceVal.Kind().String())
+			return ctx.fail(path, errors.New("gomapper: error mapping values: dest kind: struct, source kind: "+sourceVal.Kind().String()))
 		}
+
+		// Filter is consulted per call with a fieldName argument, so a cached,
+		// type-only plan can't represent it; fall back to the dynamic path.
+		if ctx.option.Filter == nil {
+			plan := getOrBuildPlan(sourceVal.Type(), destVal.Type(), ctx.option)
+			return plan.execute(sourceVal, destVal, ctx, path)
+		}
+
 		for i := 0; i < destVal.NumField(); i++ {
-			if err := mapField(sourceVal, destVal, i, exact); err != nil {
+			if err := mapField(sourceVal, destVal, i, ctx, path); err != nil {
 				return err
 			}
 		}
@@ -137,9 +232,9 @@ func mapValues(sourceVal, destVal reflect.Value, exact bool) error {
 			sourceVal = sourceVal.Elem()
 		}
 		if sourceVal.Kind() != reflect.Slice {
-			return errors.New("gomapper: error mapping values: dest kind: slice, source kind: " + sourceVal.Kind().String())
+			return ctx.fail(path, errors.New("gomapper: error mapping values: dest kind: slice, source kind: "+sourceVal.Kind().String()))
 		}
-		return mapSlice(sourceVal, destVal, exact)
+		return mapSlice(sourceVal, destVal, ctx, path)
 	}
 
 	if destVal.Kind() == reflect.Map {
@@ -150,17 +245,19 @@ func mapValues(sourceVal, destVal reflect.Value, exact bool) error {
 			sourceVal = sourceVal.Elem()
 		}
 		if sourceVal.Kind() != reflect.Map {
-			return errors.New("gomapper: error mapping values: dest kind: map, source kind: " + sourceVal.Kind().String())
+			return ctx.fail(path, errors.New("gomapper: error mapping values: dest kind: map, source kind: "+sourceVal.Kind().String()))
 		}
-		return mapMap(sourceVal, destVal, exact)
+		return mapMap(sourceVal, destVal, ctx, path)
 	}
 
-	return errors.New(fmt.Sprintf("gomapper: error mapping values: types are not compatible: Source Type: %s, Dest Type: %s", sourceVal.Type().Name(), destVal.Type().Name()))
+	return ctx.fail(path, errors.New(fmt.Sprintf("gomapper: error mapping values: types are not compatible: Source Type: %s, Dest Type: %s", sourceVal.Type().Name(), destVal.Type().Name())))
 }
 
-func mapField(source, destVal reflect.Value, i int, exact bool) error {
+func mapField(source, destVal reflect.Value, i int, ctx *mapContext, path string) error {
 	destType := destVal.Type()
-	fieldName := destType.Field(i).Name
+	destStructField := destType.Field(i)
+	fieldName := destStructField.Name
+	fieldPath := joinPath(path, fieldName)
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -172,62 +269,68 @@ func mapField(source, destVal reflect.Value, i int, exact bool) error {
 	destField := destVal.Field(i)
 
 	if !destField.CanSet() {
-		if exact {
-			return errors.New(fmt.Sprintf("gomapper: error mapping field: %s. Field can not set! DestType: %v SourceType: %v",
-				fieldName, destType, source.Type()))
+		if ctx.option.Exact {
+			return ctx.fail(fieldPath, errors.New(fmt.Sprintf("gomapper: error mapping field: %s. Field can not set! DestType: %v SourceType: %v",
+				fieldName, destType, source.Type())))
 		}
 
 		return nil
 	}
 
-	if destType.Field(i).Anonymous {
-		return mapValues(source, destField, exact)
+	if destStructField.Anonymous {
+		return mapValues(source, destField, ctx, path)
 	}
 
-	if valueIsContainedInNilEmbeddedType(source, fieldName) {
+	childCtx := ctx
+	if ctx.option.Filter != nil {
+		subFilter, ok := ctx.option.Filter.Filter(fieldName)
+		if !ok {
+			// Filtered out: leave destField at its zero value without treating
+			// this as a schema mismatch.
+			return nil
+		}
+		childCtx = ctx.withOption(ctx.option.withFilter(subFilter))
+	}
+
+	destKey, skip := fieldKey(destStructField, ctx.option.nameMapper())
+	if skip {
 		return nil
 	}
 
-	sourceField := source.FieldByName(fieldName)
-	if (sourceField == reflect.Value{}) {
-		if exact {
-			return errors.New(fmt.Sprintf("gomapper: error mapping field: %s. SourceType: %v does not contain related field. DestType: %v",
-				fieldName, source.Type(), destType))
+	sourceField, matched, reachable := findSourceField(source, destKey, ctx.option.nameMapper())
+	if !matched {
+		if ctx.option.Exact {
+			return ctx.fail(fieldPath, errors.New(fmt.Sprintf("gomapper: error mapping field: %s. SourceType: %v does not contain related field. DestType: %v",
+				fieldName, source.Type(), destType)))
 		}
 
 		return nil
 	}
 
-	return mapValues(sourceField, destField, exact)
-}
-
-func valueIsContainedInNilEmbeddedType(source reflect.Value, fieldName string) bool {
-	structField, _ := source.Type().FieldByName(fieldName)
-	ix := structField.Index
-	if len(structField.Index) > 1 {
-		parentField := source.FieldByIndex(ix[:len(ix)-1])
-		if isReflectValNil(parentField) {
-			return true
-		}
+	if !reachable {
+		// The matching field is promoted through a nil embedded pointer on the
+		// source side, so there is nothing to read from.
+		return nil
 	}
-	return false
+
+	return mapValues(sourceField, destField, childCtx, fieldPath)
 }
 
-func mapSlice(sourceVal, destVal reflect.Value, exact bool) error {
+func mapSlice(sourceVal, destVal reflect.Value, ctx *mapContext, path string) error {
 	destType := destVal.Type()
 	sourceLength := sourceVal.Len()
 	target := reflect.MakeSlice(destType, sourceLength, sourceLength)
 
 	for i := 0; i < sourceLength; i++ {
 		val := reflect.New(destType.Elem()).Elem()
-		if err := mapValues(sourceVal.Index(i), val, exact); err != nil {
+		if err := mapValues(sourceVal.Index(i), val, ctx, indexPath(path, i)); err != nil {
 			return err
 		}
 		target.Index(i).Set(val)
 	}
 
 	if sourceLength == 0 {
-		if err := verifySliceTypesAreCompatible(sourceVal, destVal, exact); err != nil {
+		if err := verifySliceTypesAreCompatible(sourceVal, destVal, ctx, path); err != nil {
 			return err
 		}
 	}
@@ -236,19 +339,19 @@ func mapSlice(sourceVal, destVal reflect.Value, exact bool) error {
 	return nil
 }
 
-func verifySliceTypesAreCompatible(sourceVal, destVal reflect.Value, exact bool) error {
+func verifySliceTypesAreCompatible(sourceVal, destVal reflect.Value, ctx *mapContext, path string) error {
 	dummyDest := reflect.New(reflect.PtrTo(destVal.Type())).Elem()
 	dummySource := reflect.MakeSlice(sourceVal.Type(), 1, 1)
-	return mapValues(dummySource, dummyDest, exact)
+	return mapValues(dummySource, dummyDest, ctx, path)
 }
 
-func mapMap(sourceVal, destVal reflect.Value, exact bool) error {
+func mapMap(sourceVal, destVal reflect.Value, ctx *mapContext, path string) error {
 	sourceKeyType := sourceVal.Type().Key()
 	destType := destVal.Type()
 	destKeyType := destType.Key()
 
 	if sourceKeyType.Name() != destKeyType.Name() {
-		return errors.New(fmt.Sprintf("gomapper: error mapping maps: map key types are not equal: Source Key Type: %s, Dest Key Type: %s", sourceKeyType.Name(), destKeyType.Name()))
+		return ctx.fail(path, errors.New(fmt.Sprintf("gomapper: error mapping maps: map key types are not equal: Source Key Type: %s, Dest Key Type: %s", sourceKeyType.Name(), destKeyType.Name())))
 	}
 
 	sourceLength := sourceVal.Len()
@@ -258,14 +361,14 @@ func mapMap(sourceVal, destVal reflect.Value, exact bool) error {
 		sourceElem := sourceVal.MapIndex(key)
 
 		destElem := reflect.New(destType.Elem()).Elem()
-		if err := mapValues(sourceElem, destElem, exact); err != nil {
+		if err := mapValues(sourceElem, destElem, ctx, mapKeyPath(path, key)); err != nil {
 			return err
 		}
 		targetMap.SetMapIndex(key, destElem)
 	}
 
 	if sourceLength == 0 {
-		if err := verifyMapElemTypesAreCompatible(sourceVal, destVal, exact); err != nil {
+		if err := verifyMapElemTypesAreCompatible(sourceVal, destVal, ctx, path); err != nil {
 			return err
 		}
 	}
@@ -274,8 +377,8 @@ func mapMap(sourceVal, destVal reflect.Value, exact bool) error {
 	return nil
 }
 
-func verifyMapElemTypesAreCompatible(sourceVal, destVal reflect.Value, exact bool) error {
+func verifyMapElemTypesAreCompatible(sourceVal, destVal reflect.Value, ctx *mapContext, path string) error {
 	dummyDestElem := reflect.New(destVal.Type().Elem()).Elem()
 	dummySourceElem := reflect.New(sourceVal.Type().Elem()).Elem()
-	return mapValues(dummySourceElem, dummyDestElem, exact)
+	return mapValues(dummySourceElem, dummyDestElem, ctx, path)
 }
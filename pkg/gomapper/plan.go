@@ -0,0 +1,174 @@
+package gomapper
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// planKey identifies a cached mappingPlan. reflect.Type identity is stable
+// for the lifetime of the program, so no invalidation is needed.
+type planKey struct {
+	src   reflect.Type
+	dst   reflect.Type
+	exact bool
+}
+
+// fieldPlan is a precomputed copy instruction for a single destination
+// struct field, resolved once per (source type, dest type) pair instead of
+// being re-resolved via FieldByName on every Map call.
+type fieldPlan struct {
+	destFieldIndex int
+	destName       string
+	anonymous      bool
+	canNotSet      bool
+	found          bool
+	sourceIndex    []int
+}
+
+// mappingPlan is an ordered list of fieldPlans for one struct type pair.
+type mappingPlan struct {
+	fields []fieldPlan
+}
+
+var planCache sync.Map // map[planKey]*mappingPlan
+
+// Precompile builds and caches the mapping plan for S -> D using the default
+// Option, so the first real Map(S, *D) call doesn't pay the cost of building
+// it. Safe to call from init for hot paths; it's a no-op if already cached.
+func Precompile[S, D any]() {
+	srcType := reflect.TypeOf((*S)(nil)).Elem()
+	destType := reflect.TypeOf((*D)(nil)).Elem()
+	getOrBuildPlan(srcType, destType, getDefaultOption())
+}
+
+func getOrBuildPlan(srcType, destType reflect.Type, option *Option) *mappingPlan {
+	// A custom NameMapper changes how fields resolve, but planKey doesn't
+	// capture it (funcs aren't comparable). Caching under a non-default
+	// mapper would let whichever caller shows up first for a given type pair
+	// silently decide field matching for everyone else, so build fresh
+	// instead of caching or reading the shared cache for this call.
+	if option.NameMapper != nil {
+		return buildMappingPlan(srcType, destType, option)
+	}
+
+	key := planKey{src: srcType, dst: destType, exact: option.Exact}
+
+	if cached, ok := planCache.Load(key); ok {
+		return cached.(*mappingPlan)
+	}
+
+	plan := buildMappingPlan(srcType, destType, option)
+	actual, _ := planCache.LoadOrStore(key, plan)
+	return actual.(*mappingPlan)
+}
+
+func buildMappingPlan(srcType, destType reflect.Type, option *Option) *mappingPlan {
+	nameMapper := option.nameMapper()
+	plan := &mappingPlan{}
+
+	for i := 0; i < destType.NumField(); i++ {
+		field := destType.Field(i)
+		fp := fieldPlan{destFieldIndex: i, destName: field.Name}
+
+		if !field.IsExported() {
+			fp.canNotSet = true
+			plan.fields = append(plan.fields, fp)
+			continue
+		}
+
+		if field.Anonymous {
+			fp.anonymous = true
+			plan.fields = append(plan.fields, fp)
+			continue
+		}
+
+		key, skip := fieldKey(field, nameMapper)
+		if skip {
+			continue
+		}
+
+		sourceField, matched := findSourceFieldType(srcType, key, nameMapper)
+		fp.found = matched
+		if matched {
+			fp.sourceIndex = sourceField.Index
+		}
+		plan.fields = append(plan.fields, fp)
+	}
+
+	return plan
+}
+
+// findSourceFieldType is the type-only counterpart of findSourceField: it
+// resolves which source field a key matches without needing a source value,
+// so the result can be cached per type pair.
+func findSourceFieldType(srcType reflect.Type, key string, nameMapper func(string) string) (reflect.StructField, bool) {
+	for _, sf := range reflect.VisibleFields(srcType) {
+		if !sf.IsExported() {
+			continue
+		}
+
+		sfKey, skip := fieldKey(sf, nameMapper)
+		if skip || sfKey != key {
+			continue
+		}
+
+		return sf, true
+	}
+
+	return reflect.StructField{}, false
+}
+
+// execute runs the plan against concrete values, using FieldByIndex + Set
+// instead of re-resolving field names.
+func (p *mappingPlan) execute(sourceVal, destVal reflect.Value, ctx *mapContext, path string) error {
+	destType := destVal.Type()
+
+	for _, fp := range p.fields {
+		if fp.canNotSet {
+			if ctx.option.Exact {
+				if err := ctx.fail(joinPath(path, fp.destName), errors.New(fmt.Sprintf(
+					"gomapper: error mapping field: %s. Field can not set! DestType: %v SourceType: %v",
+					fp.destName, destType, sourceVal.Type()))); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		destField := destVal.Field(fp.destFieldIndex)
+
+		if fp.anonymous {
+			if err := mapValues(sourceVal, destField, ctx, path); err != nil {
+				return err
+			}
+			continue
+		}
+
+		fieldPath := joinPath(path, fp.destName)
+
+		if !fp.found {
+			if ctx.option.Exact {
+				if err := ctx.fail(fieldPath, errors.New(fmt.Sprintf(
+					"gomapper: error mapping field: %s. SourceType: %v does not contain related field. DestType: %v",
+					fp.destName, sourceVal.Type(), destType))); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		sourceField, reachable := fieldByIndex(sourceVal, fp.sourceIndex)
+		if !reachable {
+			continue
+		}
+
+		if err := mapValues(sourceField, destField, ctx, fieldPath); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package gomapper
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type planCacheSource struct {
+	Name string
+}
+
+type planCacheDest struct {
+	Name string
+}
+
+// Test_GetOrBuildPlan_Caches_Default_Option guards against getOrBuildPlan
+// treating getDefaultOption()'s NameMapper as "customized" and skipping the
+// cache for the common no-options Map call.
+func Test_GetOrBuildPlan_Caches_Default_Option(t *testing.T) {
+	srcType := reflect.TypeOf(planCacheSource{})
+	destType := reflect.TypeOf(planCacheDest{})
+	key := planKey{src: srcType, dst: destType, exact: false}
+	planCache.Delete(key)
+
+	first := getOrBuildPlan(srcType, destType, getDefaultOption())
+	second := getOrBuildPlan(srcType, destType, getDefaultOption())
+
+	assert.True(t, first == second, "expected the second call to reuse the cached plan instead of rebuilding it")
+
+	cached, ok := planCache.Load(key)
+	assert.True(t, ok, "expected the plan to be stored in planCache")
+	assert.True(t, cached.(*mappingPlan) == first)
+}
+
+type precompileSource struct {
+	Name string
+}
+
+type precompileDest struct {
+	Name string
+}
+
+// Test_Precompile_Populates_Plan_Cache guards against Precompile being a
+// no-op: it must leave a plan in planCache before any Map call is made.
+func Test_Precompile_Populates_Plan_Cache(t *testing.T) {
+	srcType := reflect.TypeOf(precompileSource{})
+	destType := reflect.TypeOf(precompileDest{})
+	key := planKey{src: srcType, dst: destType, exact: false}
+	planCache.Delete(key)
+
+	Precompile[precompileSource, precompileDest]()
+
+	_, ok := planCache.Load(key)
+	assert.True(t, ok, "expected Precompile to populate planCache")
+}
+
+// Test_GetOrBuildPlan_Skips_Cache_For_Custom_NameMapper documents the
+// intentional trade-off in getOrBuildPlan: a non-default NameMapper isn't
+// representable in planKey, so it must never be served from, or written to,
+// the shared cache.
+func Test_GetOrBuildPlan_Skips_Cache_For_Custom_NameMapper(t *testing.T) {
+	srcType := reflect.TypeOf(planCacheSource{})
+	destType := reflect.TypeOf(planCacheDest{})
+	key := planKey{src: srcType, dst: destType, exact: false}
+	planCache.Delete(key)
+
+	option := &Option{NameMapper: identityNameMapper}
+	getOrBuildPlan(srcType, destType, option)
+
+	_, ok := planCache.Load(key)
+	assert.False(t, ok, "expected a custom NameMapper to bypass planCache entirely")
+}
@@ -0,0 +1,296 @@
+package gomapper
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+// StructToMap walks src the same way Map walks a source struct (embedded
+// fields, pointer dereferencing, slice/map recursion) and writes each field
+// into dst keyed by field name, or by `gomapper` tag if present. Nested
+// structs become nested map[string]any, slices become []any, and maps become
+// map[K]any. Exact and Filter are honored the same way they are for Map.
+func StructToMap(src any, dst map[string]any, options ...*Option) error {
+	option, err := verifyMapOption(options...)
+	if err != nil {
+		return err
+	}
+
+	if isAnyNil(src) {
+		return errors.New("gomapper: source must not be nil")
+	}
+
+	if dst == nil {
+		return errors.New("gomapper: dest must not be nil")
+	}
+
+	srcVal := reflect.ValueOf(src)
+	if srcVal.Kind() == reflect.Ptr {
+		srcVal = srcVal.Elem()
+	}
+
+	if srcVal.Kind() != reflect.Struct {
+		return errors.New("gomapper: error converting to map: source kind: " + srcVal.Kind().String())
+	}
+
+	return structToMap(srcVal, dst, option)
+}
+
+func structToMap(srcVal reflect.Value, dst map[string]any, option *Option) error {
+	srcType := srcVal.Type()
+
+	for i := 0; i < srcType.NumField(); i++ {
+		field := srcType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		fieldVal := srcVal.Field(i)
+
+		if field.Anonymous {
+			if fieldVal.Kind() == reflect.Ptr {
+				if fieldVal.IsNil() {
+					continue
+				}
+				fieldVal = fieldVal.Elem()
+			}
+			if fieldVal.Kind() == reflect.Struct {
+				if err := structToMap(fieldVal, dst, option); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		childOption := option
+		if option.Filter != nil {
+			subFilter, ok := option.Filter.Filter(field.Name)
+			if !ok {
+				continue
+			}
+			childOption = option.withFilter(subFilter)
+		}
+
+		key, skip := fieldKey(field, option.nameMapper())
+		if skip {
+			continue
+		}
+
+		value, err := valueToAny(fieldVal, childOption)
+		if err != nil {
+			return err
+		}
+
+		dst[key] = value
+	}
+
+	return nil
+}
+
+func valueToAny(val reflect.Value, option *Option) (any, error) {
+	if val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return nil, nil
+		}
+		return valueToAny(val.Elem(), option)
+	}
+
+	switch val.Kind() {
+	case reflect.Struct:
+		nested := map[string]any{}
+		if err := structToMap(val, nested, option); err != nil {
+			return nil, err
+		}
+		return nested, nil
+	case reflect.Slice:
+		result := make([]any, val.Len())
+		for i := 0; i < val.Len(); i++ {
+			elem, err := valueToAny(val.Index(i), option)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = elem
+		}
+		return result, nil
+	case reflect.Map:
+		anyType := reflect.TypeOf((*any)(nil)).Elem()
+		mapType := reflect.MapOf(val.Type().Key(), anyType)
+		result := reflect.MakeMapWithSize(mapType, val.Len())
+		for _, key := range val.MapKeys() {
+			elem, err := valueToAny(val.MapIndex(key), option)
+			if err != nil {
+				return nil, err
+			}
+			elemVal := reflect.Zero(anyType)
+			if elem != nil {
+				elemVal = reflect.ValueOf(elem)
+			}
+			result.SetMapIndex(key, elemVal)
+		}
+		return result.Interface(), nil
+	default:
+		return val.Interface(), nil
+	}
+}
+
+// MapToStruct is the inverse of StructToMap: for each field of dst, it looks
+// up the matching key (field name, or `gomapper` tag if present) in src and
+// reflect-converts the value into place, recursing into nested maps/slices
+// for struct/slice/map fields. Exact and Filter are honored the same way they
+// are for Map.
+func MapToStruct(src map[string]any, dst any, options ...*Option) error {
+	option, err := verifyMapOption(options...)
+	if err != nil {
+		return err
+	}
+
+	if src == nil {
+		return errors.New("gomapper: source must not be nil")
+	}
+
+	if isAnyNil(dst) {
+		return errors.New("gomapper: dest must not be nil")
+	}
+
+	if reflect.TypeOf(dst).Kind() != reflect.Ptr {
+		return errors.New("gomapper: dest must be a pointer type")
+	}
+
+	return mapToStruct(src, reflect.ValueOf(dst).Elem(), option)
+}
+
+func mapToStruct(src map[string]any, destVal reflect.Value, option *Option) error {
+	destType := destVal.Type()
+
+	for i := 0; i < destType.NumField(); i++ {
+		field := destType.Field(i)
+		fieldVal := destVal.Field(i)
+
+		if !fieldVal.CanSet() {
+			if option.Exact {
+				return errors.New(fmt.Sprintf("gomapper: error mapping field: %s. Field can not set! DestType: %v", field.Name, destType))
+			}
+			continue
+		}
+
+		if field.Anonymous {
+			embeddedVal := fieldVal
+			if embeddedVal.Kind() == reflect.Ptr {
+				if embeddedVal.IsNil() {
+					embeddedVal.Set(reflect.New(embeddedVal.Type().Elem()))
+				}
+				embeddedVal = embeddedVal.Elem()
+			}
+			if embeddedVal.Kind() == reflect.Struct {
+				if err := mapToStruct(src, embeddedVal, option); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		childOption := option
+		if option.Filter != nil {
+			subFilter, ok := option.Filter.Filter(field.Name)
+			if !ok {
+				continue
+			}
+			childOption = option.withFilter(subFilter)
+		}
+
+		key, skip := fieldKey(field, option.nameMapper())
+		if skip {
+			continue
+		}
+
+		value, ok := src[key]
+		if !ok {
+			if option.Exact {
+				return errors.New(fmt.Sprintf("gomapper: error mapping field: %s. Source map does not contain key: %s. DestType: %v",
+					field.Name, key, destType))
+			}
+			continue
+		}
+
+		if err := anyToValue(value, fieldVal, childOption); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func anyToValue(value any, destVal reflect.Value, option *Option) error {
+	if value == nil {
+		return nil
+	}
+
+	if destVal.Kind() == reflect.Ptr {
+		destValZeroPtr := reflect.New(destVal.Type().Elem())
+		if err := anyToValue(value, destValZeroPtr.Elem(), option); err != nil {
+			return err
+		}
+		destVal.Set(destValZeroPtr)
+		return nil
+	}
+
+	switch destVal.Kind() {
+	case reflect.Struct:
+		nested, ok := value.(map[string]any)
+		if !ok {
+			return errors.New(fmt.Sprintf("gomapper: error converting to struct: source value is not a map[string]any: %T", value))
+		}
+		return mapToStruct(nested, destVal, option)
+	case reflect.Slice:
+		srcSlice := reflect.ValueOf(value)
+		if srcSlice.Kind() != reflect.Slice {
+			return errors.New(fmt.Sprintf("gomapper: error converting to slice: source value is not a slice: %T", value))
+		}
+		target := reflect.MakeSlice(destVal.Type(), srcSlice.Len(), srcSlice.Len())
+		for i := 0; i < srcSlice.Len(); i++ {
+			if err := anyToValue(srcSlice.Index(i).Interface(), target.Index(i), option); err != nil {
+				return err
+			}
+		}
+		destVal.Set(target)
+		return nil
+	case reflect.Map:
+		srcMap := reflect.ValueOf(value)
+		if srcMap.Kind() != reflect.Map {
+			return errors.New(fmt.Sprintf("gomapper: error converting to map: source value is not a map: %T", value))
+		}
+		destKeyType := destVal.Type().Key()
+		target := reflect.MakeMapWithSize(destVal.Type(), srcMap.Len())
+		for _, key := range srcMap.MapKeys() {
+			destElem := reflect.New(destVal.Type().Elem()).Elem()
+			if err := anyToValue(srcMap.MapIndex(key).Interface(), destElem, option); err != nil {
+				return err
+			}
+
+			destKey := key
+			if key.Type() != destKeyType {
+				if !key.Type().ConvertibleTo(destKeyType) {
+					return errors.New(fmt.Sprintf("gomapper: error converting map: key types are not compatible: Source Key Type: %s, Dest Key Type: %s", key.Type(), destKeyType))
+				}
+				destKey = key.Convert(destKeyType)
+			}
+
+			target.SetMapIndex(destKey, destElem)
+		}
+		destVal.Set(target)
+		return nil
+	default:
+		valueVal := reflect.ValueOf(value)
+		if valueVal.Type() == destVal.Type() {
+			destVal.Set(valueVal)
+			return nil
+		}
+		if valueVal.Type().ConvertibleTo(destVal.Type()) {
+			destVal.Set(valueVal.Convert(destVal.Type()))
+			return nil
+		}
+		return errors.New(fmt.Sprintf("gomapper: error converting value: types are not compatible: Source Type: %s, Dest Type: %s", valueVal.Type(), destVal.Type()))
+	}
+}
@@ -0,0 +1,67 @@
+package converters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Trendyol/gomapper/pkg/gomapper"
+	"github.com/stretchr/testify/assert"
+)
+
+type SourceWithTimeString struct {
+	CreatedAt string
+}
+
+type DestWithTime struct {
+	CreatedAt time.Time
+}
+
+type SourceWithString struct {
+	Name string
+}
+
+type DestWithStruct struct {
+	Name struct{ Value string }
+}
+
+func Test_String_To_Struct_Without_Converter_Still_Errors(t *testing.T) {
+	source := SourceWithString{Name: "abc"}
+
+	var dest DestWithStruct
+	err := gomapper.Map(source, &dest)
+
+	assert.NotNil(t, err)
+}
+
+func Test_Registered_Converter_Handles_String_To_Struct(t *testing.T) {
+	source := SourceWithString{Name: "abc"}
+
+	var dest DestWithStruct
+	option := gomapper.WithConverter(&gomapper.Option{}, func(s string) (struct{ Value string }, error) {
+		return struct{ Value string }{Value: s}, nil
+	})
+
+	err := gomapper.Map(source, &dest, option)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", dest.Name.Value)
+}
+
+func Test_Default_Converters_Parses_Time(t *testing.T) {
+	source := SourceWithTimeString{CreatedAt: "2024-01-02T15:04:05Z"}
+
+	var dest DestWithTime
+	err := gomapper.Map(source, &dest, &gomapper.Option{DefaultConverters: true})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2024, dest.CreatedAt.Year())
+}
+
+func Test_Without_Default_Converters_Time_String_Mismatch_Errors(t *testing.T) {
+	source := SourceWithTimeString{CreatedAt: "2024-01-02T15:04:05Z"}
+
+	var dest DestWithTime
+	err := gomapper.Map(source, &dest)
+
+	assert.NotNil(t, err)
+}
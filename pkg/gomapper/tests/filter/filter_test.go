@@ -0,0 +1,86 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/Trendyol/gomapper/pkg/gomapper"
+	"github.com/stretchr/testify/assert"
+)
+
+type Role struct {
+	Size  int
+	Count int
+}
+
+type Flavor struct {
+	Type  string
+	Roles []Role
+}
+
+type Root struct {
+	Flavor Flavor
+	Name   string
+}
+
+func Test_MaskFromPaths_Only_Maps_Listed_Fields(t *testing.T) {
+	source := Root{
+		Flavor: Flavor{
+			Type:  "small",
+			Roles: []Role{{Size: 50, Count: 5}},
+		},
+		Name: "root",
+	}
+
+	var dest Root
+	err := gomapper.Map(source, &dest, &gomapper.Option{
+		Filter: gomapper.MaskFromPaths([]string{"Flavor.Roles.Size"}),
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "", dest.Flavor.Type)
+	assert.Equal(t, 50, dest.Flavor.Roles[0].Size)
+	assert.Equal(t, 0, dest.Flavor.Roles[0].Count)
+	assert.Equal(t, "", dest.Name)
+}
+
+func Test_MaskInverse_Maps_Everything_Except_Listed_Fields(t *testing.T) {
+	source := Root{
+		Flavor: Flavor{
+			Type:  "small",
+			Roles: []Role{{Size: 50, Count: 5}},
+		},
+		Name: "root",
+	}
+
+	var dest Root
+	err := gomapper.Map(source, &dest, &gomapper.Option{
+		Filter: gomapper.MaskInverse([]string{"Flavor.Roles.Size"}),
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "small", dest.Flavor.Type)
+	assert.Equal(t, 0, dest.Flavor.Roles[0].Size)
+	assert.Equal(t, 5, dest.Flavor.Roles[0].Count)
+	assert.Equal(t, "root", dest.Name)
+}
+
+func Test_Filtered_Out_Field_Does_Not_Trigger_Exact_Error(t *testing.T) {
+	type PartialRoot struct {
+		Flavor Flavor
+		Extra  string
+	}
+
+	source := Root{
+		Flavor: Flavor{Type: "small"},
+		Name:   "root",
+	}
+
+	var dest PartialRoot
+	err := gomapper.Map(source, &dest, &gomapper.Option{
+		Exact:  true,
+		Filter: gomapper.MaskFromPaths([]string{"Flavor.Type"}),
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "small", dest.Flavor.Type)
+}
@@ -0,0 +1,74 @@
+package multierror
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Trendyol/gomapper/pkg/gomapper"
+	"github.com/stretchr/testify/assert"
+)
+
+type NestedSource struct {
+	X string
+}
+
+type NestedDest struct {
+	X string
+	Y string
+}
+
+type Source struct {
+	A      string
+	B      int
+	Nested NestedSource
+}
+
+type Dest struct {
+	A      string
+	B      int
+	Extra  string
+	Nested NestedDest
+}
+
+func Test_Exact_Collects_Multiple_Errors_With_Paths(t *testing.T) {
+	source := Source{A: "a", B: 1, Nested: NestedSource{X: "x"}}
+
+	var dest Dest
+	err := gomapper.Map(source, &dest, &gomapper.Option{Exact: true})
+
+	assert.NotNil(t, err)
+
+	var mapErr *gomapper.MapError
+	assert.True(t, errors.As(err, &mapErr))
+	assert.Len(t, mapErr.Errors, 2)
+
+	paths := []string{mapErr.Errors[0].Path, mapErr.Errors[1].Path}
+	assert.Contains(t, paths, "Extra")
+	assert.Contains(t, paths, "Nested.Y")
+
+	// Fields that did match are still copied, despite the two errors.
+	assert.Equal(t, "a", dest.A)
+	assert.Equal(t, 1, dest.B)
+	assert.Equal(t, "x", dest.Nested.X)
+}
+
+func Test_StopOnFirstError_Aborts_Instead_Of_Collecting(t *testing.T) {
+	source := Source{A: "a", B: 1, Nested: NestedSource{X: "x"}}
+
+	var dest Dest
+	err := gomapper.Map(source, &dest, &gomapper.Option{Exact: true, StopOnFirstError: true})
+
+	assert.NotNil(t, err)
+
+	var mapErr *gomapper.MapError
+	assert.False(t, errors.As(err, &mapErr))
+}
+
+func Test_No_Errors_Returns_Nil(t *testing.T) {
+	source := Source{A: "a", B: 1, Nested: NestedSource{X: "x"}}
+
+	var dest Dest
+	err := gomapper.Map(source, &dest)
+
+	assert.Nil(t, err)
+}
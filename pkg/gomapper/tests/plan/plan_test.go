@@ -0,0 +1,43 @@
+package plan
+
+import (
+	"testing"
+
+	"github.com/Trendyol/gomapper/pkg/gomapper"
+	"github.com/stretchr/testify/assert"
+)
+
+type Source struct {
+	Name string
+	Age  int
+}
+
+type Dest struct {
+	Name string
+	Age  int
+}
+
+func Test_Precompile_Then_Map_Still_Works(t *testing.T) {
+	gomapper.Precompile[Source, Dest]()
+
+	source := Source{Name: "john", Age: 30}
+
+	var dest Dest
+	err := gomapper.Map(source, &dest)
+
+	assert.Nil(t, err)
+	assert.Equal(t, source.Name, dest.Name)
+	assert.Equal(t, source.Age, dest.Age)
+}
+
+func Test_Map_Repeated_Calls_Use_Cached_Plan(t *testing.T) {
+	for i := 0; i < 5; i++ {
+		source := Source{Name: "john", Age: i}
+
+		var dest Dest
+		err := gomapper.Map(source, &dest)
+
+		assert.Nil(t, err)
+		assert.Equal(t, i, dest.Age)
+	}
+}
@@ -0,0 +1,34 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/Trendyol/gomapper/pkg/gomapper"
+)
+
+// Benchmark_Map_Nested_Slice_Of_Struct maps RootX -> RootY, which wraps the
+// FlavorX -> FlavorY shape of Test_Slice_When_Dest_Ptr in slice_test.go, but
+// with a sizeable slice, to show
+// the win from caching mapping plans instead of re-resolving field names on
+// every call.
+func Benchmark_Map_Nested_Slice_Of_Struct(b *testing.B) {
+	size := 50
+	count := 5
+
+	roles := make([]RoleX, 200)
+	for i := range roles {
+		roles[i] = RoleX{Size: &size, Count: &count}
+	}
+
+	source := RootX{Flavor: &FlavorX{Type: "small", Roles: &roles}}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var dest RootY
+		if err := gomapper.Map(&source, &dest); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
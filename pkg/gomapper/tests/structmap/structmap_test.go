@@ -0,0 +1,106 @@
+package structmap
+
+import (
+	"testing"
+
+	"github.com/Trendyol/gomapper/pkg/gomapper"
+	"github.com/stretchr/testify/assert"
+)
+
+type Address struct {
+	City string
+}
+
+type Person struct {
+	Name    string
+	Age     int
+	Address Address
+	Tags    []string
+}
+
+func Test_StructToMap_Nests_Struct_Slice_Fields(t *testing.T) {
+	source := Person{
+		Name:    "john",
+		Age:     30,
+		Address: Address{City: "istanbul"},
+		Tags:    []string{"a", "b"},
+	}
+
+	dst := map[string]any{}
+	err := gomapper.StructToMap(source, dst)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "john", dst["Name"])
+	assert.Equal(t, 30, dst["Age"])
+	assert.Equal(t, "istanbul", dst["Address"].(map[string]any)["City"])
+	assert.Equal(t, []any{"a", "b"}, dst["Tags"])
+}
+
+func Test_MapToStruct_Reconstructs_Struct_From_Map(t *testing.T) {
+	source := map[string]any{
+		"Name": "john",
+		"Age":  30,
+		"Address": map[string]any{
+			"City": "istanbul",
+		},
+		"Tags": []any{"a", "b"},
+	}
+
+	var dest Person
+	err := gomapper.MapToStruct(source, &dest)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "john", dest.Name)
+	assert.Equal(t, 30, dest.Age)
+	assert.Equal(t, "istanbul", dest.Address.City)
+	assert.Equal(t, []string{"a", "b"}, dest.Tags)
+}
+
+func Test_MapToStruct_Exact_Errors_On_Missing_Key(t *testing.T) {
+	source := map[string]any{
+		"Name": "john",
+	}
+
+	var dest Person
+	err := gomapper.MapToStruct(source, &dest, &gomapper.Option{Exact: true})
+
+	assert.NotNil(t, err)
+}
+
+func Test_MapToStruct_Promotes_Embedded_Pointer_Struct(t *testing.T) {
+	type Embedded struct {
+		City string
+	}
+
+	type WithEmbeddedPointer struct {
+		*Embedded
+		Name string
+	}
+
+	source := map[string]any{
+		"City": "istanbul",
+		"Name": "john",
+	}
+
+	var dest WithEmbeddedPointer
+	err := gomapper.MapToStruct(source, &dest)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, dest.Embedded)
+	assert.Equal(t, "istanbul", dest.City)
+	assert.Equal(t, "john", dest.Name)
+}
+
+func Test_StructToMap_Honors_Tag(t *testing.T) {
+	type Tagged struct {
+		Name string `gomapper:"full_name"`
+	}
+
+	source := Tagged{Name: "john"}
+
+	dst := map[string]any{}
+	err := gomapper.StructToMap(source, dst)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "john", dst["full_name"])
+}
@@ -0,0 +1,85 @@
+package tags
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Trendyol/gomapper/pkg/gomapper"
+	"github.com/stretchr/testify/assert"
+)
+
+type SourceWithTag struct {
+	UserName string `gomapper:"Name"`
+	Ignored  string `gomapper:"-"`
+}
+
+type DestPlain struct {
+	Name    string
+	Ignored string
+}
+
+type SourceSnakeCase struct {
+	UserName string
+}
+
+type DestWithTag struct {
+	Name string `gomapper:"UserName"`
+}
+
+type SourceNoMatch struct {
+	FirstName string
+}
+
+type DestNoMatch struct {
+	FirstName string
+}
+
+func Test_Source_Tag_Renames_Field(t *testing.T) {
+	source := SourceWithTag{UserName: "john", Ignored: "skip-me"}
+
+	var dest DestPlain
+	err := gomapper.Map(source, &dest)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "john", dest.Name)
+	assert.Equal(t, "", dest.Ignored)
+}
+
+func Test_Dest_Tag_Renames_Field(t *testing.T) {
+	source := SourceSnakeCase{UserName: "john"}
+
+	var dest DestWithTag
+	err := gomapper.Map(source, &dest)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "john", dest.Name)
+}
+
+func Test_Name_Mapper_Is_Used_When_No_Tag_Present(t *testing.T) {
+	type destLowercase struct {
+		Username string
+	}
+
+	source := SourceSnakeCase{UserName: "john"}
+
+	var dest destLowercase
+	err := gomapper.Map(source, &dest, &gomapper.Option{
+		NameMapper: func(name string) string {
+			return strings.ToLower(name)
+		},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "john", dest.Username)
+}
+
+func Test_Exact_Errors_When_No_Field_Matches(t *testing.T) {
+	source := SourceNoMatch{FirstName: "john"}
+
+	var dest struct {
+		FirstName string `gomapper:"LastName"`
+	}
+	err := gomapper.Map(source, &dest, &gomapper.Option{Exact: true})
+
+	assert.NotNil(t, err)
+}